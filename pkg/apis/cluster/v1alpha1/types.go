@@ -0,0 +1,184 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Cluster represents the desire state and status of a member cluster.
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of a member cluster.
+	Spec ClusterSpec `json:"spec"`
+
+	// Status describes the current status of a member cluster.
+	// +optional
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// ClusterSpec defines the desired state of a member cluster.
+type ClusterSpec struct {
+	// SyncMode describes how a cluster synchronizes the resources it hosts, either
+	// Push or Pull.
+	// +optional
+	SyncMode ClusterSyncMode `json:"syncMode,omitempty"`
+
+	// APIEndpoint is the API endpoint of the member cluster. This can be a hostname,
+	// hostname:port, IP or IP:port.
+	// +optional
+	APIEndpoint string `json:"apiEndpoint,omitempty"`
+
+	// SecretRef represents the secret contains mandatory credentials to access the
+	// member cluster.
+	// +optional
+	SecretRef *LocalSecretReference `json:"secretRef,omitempty"`
+
+	// InsecureSkipTLSVerification indicates that the karmada control plane should not
+	// confirm the validity of the serving certificate of the cluster it is connecting
+	// to.
+	// +optional
+	InsecureSkipTLSVerification bool `json:"insecureSkipTLSVerification,omitempty"`
+
+	// Taints attached to the cluster, preventing resources from being propagated to
+	// this cluster unless they tolerate the taints.
+	// +optional
+	Taints []corev1.Taint `json:"taints,omitempty"`
+
+	// PreserveResourcesOnDeletion controls whether resources propagated to this cluster
+	// are preserved when the Cluster object is deleted. When true, the execution space
+	// is removed with an Orphan propagation policy and every Work inside it is patched
+	// with spec.preserveResourcesOnDeletion=true so that existing workloads keep running
+	// on the member cluster. This is used to detach a cluster from Karmada management,
+	// e.g. while migrating it to a different control plane, without disrupting live
+	// traffic. Defaults to false, meaning resources are cascade-deleted along with the
+	// cluster. Only the Namespace execution-space mode reads this field; the Remote mode
+	// deletes its member-cluster namespace the same way whether or not it is set.
+	// +optional
+	PreserveResourcesOnDeletion *bool `json:"preserveResourcesOnDeletion,omitempty"`
+
+	// ExecutionSpace configures where and how this cluster's execution space -- the
+	// place Work objects targeting it live -- is hosted. Defaults to a namespace inside
+	// the karmada control plane when unset.
+	// +optional
+	ExecutionSpace *ExecutionSpace `json:"executionSpace,omitempty"`
+
+	// ForceDeletion, when true, asks the cluster controller to forcibly strip leftover
+	// finalizers from this cluster's execution space (and the Works inside it) once it
+	// has been terminating for longer than the controller's force-deletion grace period,
+	// instead of waiting for it indefinitely. This only takes effect when the controller
+	// manager itself was started with force-deletion capability enabled; it scopes that
+	// capability to this cluster rather than forcing every terminating cluster. Set via
+	// `karmadactl unregister --force`. Defaults to false.
+	// +optional
+	ForceDeletion *bool `json:"forceDeletion,omitempty"`
+}
+
+// ExecutionSpace holds the configuration for where and how a cluster's execution space
+// is hosted.
+type ExecutionSpace struct {
+	// Mode selects which backend hosts this cluster's execution space.
+	// Defaults to Namespace when empty.
+	// +optional
+	Mode ExecutionSpaceMode `json:"mode,omitempty"`
+}
+
+// ExecutionSpaceMode identifies an execution-space backend.
+type ExecutionSpaceMode string
+
+const (
+	// ExecutionSpaceModeNamespace hosts the execution space as a namespace inside the
+	// karmada control plane. This is the default.
+	ExecutionSpaceModeNamespace ExecutionSpaceMode = "Namespace"
+	// ExecutionSpaceModeRemote hosts the execution space inside the member cluster
+	// itself, with the karmada control plane holding only a pointer to it.
+	ExecutionSpaceModeRemote ExecutionSpaceMode = "Remote"
+	// ExecutionSpaceModeVCluster hosts the execution space inside an isolated vcluster,
+	// for tenants whose isolation requirements rule out sharing the control plane's own
+	// namespaces.
+	ExecutionSpaceModeVCluster ExecutionSpaceMode = "VCluster"
+)
+
+// ClusterSyncMode describes the mode of synchronization between the member cluster and
+// the karmada control plane.
+type ClusterSyncMode string
+
+const (
+	// Push means that the karmada control plane will in charge of synchronizing
+	// resources to the member cluster.
+	Push ClusterSyncMode = "Push"
+	// Pull means that the karmada-agent running in the member cluster will in charge of
+	// synchronizing resources from the karmada control plane.
+	Pull ClusterSyncMode = "Pull"
+)
+
+// LocalSecretReference is a reference to a secret within the enclosing namespace.
+type LocalSecretReference struct {
+	// Namespace is the namespace of the secret.
+	Namespace string `json:"namespace"`
+	// Name is the name of the secret.
+	Name string `json:"name"`
+}
+
+// ClusterStatus contains information about the current status of a member cluster.
+type ClusterStatus struct {
+	// Conditions is an array of current cluster conditions.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+const (
+	// ClusterConditionSchedulable reports whether the scheduler was able to place
+	// ResourceBindings onto this cluster the last time it was considered. Its Reason
+	// is one of the ClusterNotSchedulableReason* constants and its Message lists the
+	// most recent ResourceBindings that were filtered out, if any.
+	ClusterConditionSchedulable = "Schedulable"
+
+	// ClusterConditionExecutionSpaceReady reports whether the cluster controller was
+	// able to provision this cluster's execution space. False generally means
+	// Spec.ExecutionSpace.Mode selects a backend the running controller-manager does
+	// not implement, so the cluster can never finish joining until the mode is
+	// corrected or the controller-manager is upgraded.
+	ClusterConditionExecutionSpaceReady = "ExecutionSpaceReady"
+)
+
+const (
+	// ClusterNotSchedulableReasonTaintToleration means the cluster was filtered out
+	// because the ResourceBinding did not tolerate one or more of its taints.
+	ClusterNotSchedulableReasonTaintToleration = "TaintToleration"
+	// ClusterNotSchedulableReasonClusterAffinity means the cluster was filtered out
+	// because it did not match the ResourceBinding's cluster affinity.
+	ClusterNotSchedulableReasonClusterAffinity = "ClusterAffinity"
+	// ClusterNotSchedulableReasonAPIEnablement means the cluster was filtered out
+	// because it does not enable an API required by the resource being propagated.
+	ClusterNotSchedulableReasonAPIEnablement = "APIEnablement"
+)
+
+const (
+	// ClusterExecutionSpaceReasonUnsupportedMode means Spec.ExecutionSpace.Mode selects
+	// an ExecutionSpaceProvider that exists as an API value but is not implemented by
+	// the running controller-manager.
+	ClusterExecutionSpaceReasonUnsupportedMode = "UnsupportedMode"
+	// ClusterExecutionSpaceReasonProvisioningFailed means the selected
+	// ExecutionSpaceProvider is implemented but failed to provision the execution space.
+	ClusterExecutionSpaceReasonProvisioningFailed = "ProvisioningFailed"
+	// ClusterExecutionSpaceReasonReady means the execution space was provisioned
+	// successfully.
+	ClusterExecutionSpaceReasonReady = "Ready"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterList contains a list of Cluster.
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items holds a list of Cluster.
+	Items []Cluster `json:"items"`
+}
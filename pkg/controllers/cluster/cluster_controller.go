@@ -2,12 +2,15 @@ package cluster
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
@@ -17,7 +20,6 @@ import (
 
 	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	"github.com/karmada-io/karmada/pkg/util"
-	"github.com/karmada-io/karmada/pkg/util/names"
 )
 
 const (
@@ -25,6 +27,12 @@ const (
 	ControllerName           = "cluster-controller"
 	executionSpaceLabelKey   = "karmada.io/executionspace"
 	executionSpaceLabelValue = ""
+
+	// defaultForceDeletionGracePeriod is the default duration the controller waits after
+	// a Cluster's DeletionTimestamp is set before it starts forcibly stripping finalizers
+	// from a stuck execution space, when ForceDeletion is enabled and no explicit
+	// ForceDeletionGracePeriod is configured.
+	defaultForceDeletionGracePeriod = 2 * time.Minute
 )
 
 // Controller is to sync Cluster.
@@ -32,6 +40,17 @@ type Controller struct {
 	client.Client                      // used to operate Cluster resources.
 	KubeClientSet kubernetes.Interface // used to get kubernetes resources.
 	EventRecorder record.EventRecorder
+
+	// ForceDeletion is the controller-manager-wide capability gate for force-deletion: when
+	// false, no cluster can be force-deleted regardless of its own Spec.ForceDeletion.
+	// When true, it unblocks force-deletion for whichever clusters request it via
+	// Spec.ForceDeletion (set by `karmadactl unregister --force`), stripping finalizers
+	// from their execution space (and the Works inside it) once ForceDeletionGracePeriod
+	// has elapsed since DeletionTimestamp.
+	ForceDeletion bool
+	// ForceDeletionGracePeriod bounds how long the controller waits for a graceful
+	// deletion before ForceDeletion kicks in. Defaults to defaultForceDeletionGracePeriod.
+	ForceDeletionGracePeriod time.Duration
 }
 
 // Reconcile performs a full reconciliation for the object referred to by the Request.
@@ -64,7 +83,10 @@ func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
 
 func (c *Controller) syncCluster(cluster *v1alpha1.Cluster) (controllerruntime.Result, error) {
 	// create execution space
-	err := c.createExecutionSpace(cluster)
+	err := c.executionSpaceProviderFor(cluster).Ensure(cluster)
+	if condErr := c.recordExecutionSpaceReady(cluster, err); condErr != nil {
+		klog.Errorf("Failed to record ExecutionSpaceReady condition for cluster %v: %v", cluster.Name, condErr)
+	}
 	if err != nil {
 		return controllerruntime.Result{Requeue: true}, err
 	}
@@ -73,8 +95,42 @@ func (c *Controller) syncCluster(cluster *v1alpha1.Cluster) (controllerruntime.R
 	return c.ensureFinalizer(cluster)
 }
 
+// recordExecutionSpaceReady reflects the outcome of provisioning cluster's execution
+// space onto its ClusterConditionExecutionSpaceReady condition, so that a cluster whose
+// Spec.ExecutionSpace.Mode selects an unimplemented provider fails visibly on the Cluster
+// object instead of only via repeated Reconcile-error log lines. ensureErr is the error
+// (if any) returned by the provider's Ensure call.
+func (c *Controller) recordExecutionSpaceReady(cluster *v1alpha1.Cluster, ensureErr error) error {
+	condition := metav1.Condition{
+		Type:    v1alpha1.ClusterConditionExecutionSpaceReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  v1alpha1.ClusterExecutionSpaceReasonReady,
+		Message: "execution space provisioned successfully",
+	}
+	if ensureErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Message = ensureErr.Error()
+		condition.Reason = v1alpha1.ClusterExecutionSpaceReasonProvisioningFailed
+		var unimplemented *unimplementedModeError
+		if goerrors.As(ensureErr, &unimplemented) {
+			condition.Reason = v1alpha1.ClusterExecutionSpaceReasonUnsupportedMode
+			c.EventRecorder.Eventf(cluster, corev1.EventTypeWarning, "UnsupportedExecutionSpaceMode", ensureErr.Error())
+		}
+	}
+
+	if existing := meta.FindStatusCondition(cluster.Status.Conditions, condition.Type); existing != nil &&
+		existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message {
+		return nil
+	}
+
+	meta.SetStatusCondition(&cluster.Status.Conditions, condition)
+	return c.Client.Status().Update(context.TODO(), cluster)
+}
+
 func (c *Controller) removeCluster(cluster *v1alpha1.Cluster) (controllerruntime.Result, error) {
-	err := c.removeExecutionSpace(cluster)
+	provider := c.executionSpaceProviderFor(cluster)
+
+	err := provider.Remove(cluster)
 	if apierrors.IsNotFound(err) {
 		return c.removeFinalizer(cluster)
 	}
@@ -84,49 +140,44 @@ func (c *Controller) removeCluster(cluster *v1alpha1.Cluster) (controllerruntime
 	}
 
 	// make sure the given execution space has been deleted
-	existES, err := c.ensureRemoveExecutionSpace(cluster)
+	existES, err := provider.Exists(cluster)
 	if err != nil {
 		klog.Errorf("Failed to check weather the execution space exist in the given member cluster or not, error is: %v", err)
 		return controllerruntime.Result{Requeue: true}, err
 	} else if existES {
+		if c.ForceDeletion && clusterRequestsForceDeletion(cluster) && c.forceDeletionGracePeriodElapsed(cluster) {
+			forceRemover, ok := provider.(ExecutionSpaceForceRemover)
+			if !ok {
+				return controllerruntime.Result{Requeue: true}, fmt.Errorf("execution space provider for cluster %v does not support force deletion", cluster.Name)
+			}
+			if err := forceRemover.ForceRemove(cluster); err != nil {
+				klog.Errorf("Failed to force remove execution space %v, err is %v", cluster.Name, err)
+				return controllerruntime.Result{Requeue: true}, err
+			}
+			return c.removeFinalizer(cluster)
+		}
 		return controllerruntime.Result{Requeue: true}, fmt.Errorf("requeuing operation until the execution space %v deleted, ", cluster.Name)
 	}
 
 	return c.removeFinalizer(cluster)
 }
 
-// removeExecutionSpace delete the given execution space
-func (c *Controller) removeExecutionSpace(cluster *v1alpha1.Cluster) error {
-	executionSpace, err := names.GenerateExecutionSpaceName(cluster.Name)
-	if err != nil {
-		klog.Errorf("Failed to generate execution space name for member cluster %s, err is %v", cluster.Name, err)
-		return err
-	}
-
-	if err := c.KubeClientSet.CoreV1().Namespaces().Delete(context.TODO(), executionSpace, v1.DeleteOptions{}); err != nil {
-		klog.Errorf("Error while deleting namespace %s: %s", executionSpace, err)
-		return err
-	}
-	return nil
+// clusterRequestsForceDeletion reports whether cluster itself has asked to be
+// force-deleted, via Spec.ForceDeletion (set by `karmadactl unregister --force`). This is
+// independent of Controller.ForceDeletion, which gates whether the capability is available
+// at all in this controller-manager.
+func clusterRequestsForceDeletion(cluster *v1alpha1.Cluster) bool {
+	return cluster.Spec.ForceDeletion != nil && *cluster.Spec.ForceDeletion
 }
 
-// ensureRemoveExecutionSpace make sure the given execution space has been deleted
-func (c *Controller) ensureRemoveExecutionSpace(cluster *v1alpha1.Cluster) (bool, error) {
-	executionSpace, err := names.GenerateExecutionSpaceName(cluster.Name)
-	if err != nil {
-		klog.Errorf("Failed to generate execution space name for member cluster %s, err is %v", cluster.Name, err)
-		return false, err
-	}
-
-	_, err = c.KubeClientSet.CoreV1().Namespaces().Get(context.TODO(), executionSpace, v1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		return false, nil
+// forceDeletionGracePeriodElapsed reports whether ForceDeletionGracePeriod (or
+// defaultForceDeletionGracePeriod when unset) has passed since cluster's DeletionTimestamp.
+func (c *Controller) forceDeletionGracePeriodElapsed(cluster *v1alpha1.Cluster) bool {
+	grace := c.ForceDeletionGracePeriod
+	if grace <= 0 {
+		grace = defaultForceDeletionGracePeriod
 	}
-	if err != nil {
-		klog.Errorf("Failed to get execution space %v, err is %v ", executionSpace, err)
-		return false, err
-	}
-	return true, nil
+	return time.Since(cluster.DeletionTimestamp.Time) >= grace
 }
 
 func (c *Controller) removeFinalizer(cluster *v1alpha1.Cluster) (controllerruntime.Result, error) {
@@ -156,34 +207,3 @@ func (c *Controller) ensureFinalizer(cluster *v1alpha1.Cluster) (controllerrunti
 
 	return controllerruntime.Result{}, nil
 }
-
-// createExecutionSpace create member cluster execution space when member cluster joined
-func (c *Controller) createExecutionSpace(cluster *v1alpha1.Cluster) error {
-	executionSpace, err := names.GenerateExecutionSpaceName(cluster.Name)
-	if err != nil {
-		klog.Errorf("Failed to generate execution space name for member cluster %s, err is %v", cluster.Name, err)
-		return err
-	}
-
-	// create member cluster execution space when member cluster joined
-	_, err = c.KubeClientSet.CoreV1().Namespaces().Get(context.TODO(), executionSpace, v1.GetOptions{})
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			clusterES := &corev1.Namespace{
-				ObjectMeta: v1.ObjectMeta{
-					Name:   executionSpace,
-					Labels: map[string]string{executionSpaceLabelKey: executionSpaceLabelValue},
-				},
-			}
-			_, err = c.KubeClientSet.CoreV1().Namespaces().Create(context.TODO(), clusterES, v1.CreateOptions{})
-			if err != nil {
-				klog.Errorf("Failed to create execution space for cluster %v", cluster.Name)
-				return err
-			}
-		} else {
-			klog.Errorf("Could not get %s namespace: %v", executionSpace, err)
-			return err
-		}
-	}
-	return nil
-}
@@ -0,0 +1,96 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+func newTestController(t *testing.T, cluster *v1alpha1.Cluster, works []*workv1alpha1.Work, namespace *corev1.Namespace) *Controller {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add v1alpha1 scheme: %v", err)
+	}
+	if err := workv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add workv1alpha1 scheme: %v", err)
+	}
+
+	objs := []runtime.Object{cluster}
+	for _, w := range works {
+		objs = append(objs, w)
+	}
+
+	kubeObjs := []runtime.Object{}
+	if namespace != nil {
+		kubeObjs = append(kubeObjs, namespace)
+	}
+
+	return &Controller{
+		Client:        fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		KubeClientSet: fakeclientset.NewSimpleClientset(kubeObjs...),
+		EventRecorder: record.NewFakeRecorder(10),
+	}
+}
+
+func TestRemoveExecutionSpace_CascadeDelete(t *testing.T) {
+	clusterName := "member1"
+	executionSpace, err := names.GenerateExecutionSpaceName(clusterName)
+	if err != nil {
+		t.Fatalf("failed to generate execution space name: %v", err)
+	}
+
+	cluster := &v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName}}
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: executionSpace}}
+
+	c := newTestController(t, cluster, nil, namespace)
+
+	if err := c.executionSpaceProviderFor(cluster).Remove(cluster); err != nil {
+		t.Fatalf("Remove() returned error: %v", err)
+	}
+
+	_, err = c.KubeClientSet.CoreV1().Namespaces().Get(context.TODO(), executionSpace, metav1.GetOptions{})
+	if err == nil {
+		t.Fatalf("expected execution space %s to be deleted", executionSpace)
+	}
+}
+
+func TestRemoveExecutionSpace_PreserveResourcesOnDeletion(t *testing.T) {
+	clusterName := "member2"
+	executionSpace, err := names.GenerateExecutionSpaceName(clusterName)
+	if err != nil {
+		t.Fatalf("failed to generate execution space name: %v", err)
+	}
+
+	preserve := true
+	cluster := &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+		Spec:       v1alpha1.ClusterSpec{PreserveResourcesOnDeletion: &preserve},
+	}
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: executionSpace}}
+	work := &workv1alpha1.Work{ObjectMeta: metav1.ObjectMeta{Name: "work1", Namespace: executionSpace}}
+
+	c := newTestController(t, cluster, []*workv1alpha1.Work{work}, namespace)
+
+	if err := c.executionSpaceProviderFor(cluster).Remove(cluster); err != nil {
+		t.Fatalf("Remove() returned error: %v", err)
+	}
+
+	updatedWork := &workv1alpha1.Work{}
+	if err := c.Client.Get(context.TODO(), client.ObjectKey{Namespace: executionSpace, Name: "work1"}, updatedWork); err != nil {
+		t.Fatalf("failed to get work after Remove: %v", err)
+	}
+	if updatedWork.Spec.PreserveResourcesOnDeletion == nil || !*updatedWork.Spec.PreserveResourcesOnDeletion {
+		t.Fatalf("expected work to be marked PreserveResourcesOnDeletion")
+	}
+}
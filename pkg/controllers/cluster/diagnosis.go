@@ -0,0 +1,93 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+)
+
+// maxReportedRejectingBindings bounds how many rejecting ResourceBindings are listed in
+// the Schedulable condition's message, so it doesn't grow unbounded on a busy cluster.
+const maxReportedRejectingBindings = 5
+
+// RecordFilteredOut is called by scheduler.core.GenericScheduler.RunFilterPlugins every
+// time a FilterPlugin rejects a cluster for a ResourceBinding. It aggregates the rejection
+// onto the cluster's Schedulable condition, so placement failures are visible on the
+// Cluster object itself instead of only in the scheduler's klog output, and emits a
+// FilteredOut event for the (cluster, binding) pair. When the resulting condition is
+// identical to the one already recorded, neither the status update nor the event happens.
+func (c *Controller) RecordFilteredOut(clusterName, reason string, binding types.NamespacedName) error {
+	cluster := &v1alpha1.Cluster{}
+	if err := c.Client.Get(context.TODO(), types.NamespacedName{Name: clusterName}, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get cluster %s to record filter diagnosis: %v", clusterName, err)
+	}
+
+	rejectingBindings := appendRejectingBinding(existingSchedulableMessage(cluster), binding)
+	condition := metav1.Condition{
+		Type:    v1alpha1.ClusterConditionSchedulable,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: fmt.Sprintf("rejected for: %s", strings.Join(rejectingBindings, ", ")),
+	}
+
+	if existing := meta.FindStatusCondition(cluster.Status.Conditions, condition.Type); existing != nil &&
+		existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message {
+		return nil
+	}
+
+	meta.SetStatusCondition(&cluster.Status.Conditions, condition)
+	if err := c.Client.Status().Update(context.TODO(), cluster); err != nil {
+		return fmt.Errorf("failed to update Schedulable condition for cluster %s: %v", clusterName, err)
+	}
+
+	c.EventRecorder.Eventf(cluster, corev1.EventTypeWarning, "FilteredOut",
+		"Cluster %s was filtered out for ResourceBinding %s/%s: %s", clusterName, binding.Namespace, binding.Name, reason)
+	return nil
+}
+
+// existingSchedulableMessage returns the rejecting-binding list already recorded in the
+// cluster's Schedulable condition message, if any.
+func existingSchedulableMessage(cluster *v1alpha1.Cluster) []string {
+	condition := meta.FindStatusCondition(cluster.Status.Conditions, v1alpha1.ClusterConditionSchedulable)
+	if condition == nil {
+		return nil
+	}
+
+	const prefix = "rejected for: "
+	if !strings.HasPrefix(condition.Message, prefix) {
+		return nil
+	}
+	return strings.Split(strings.TrimPrefix(condition.Message, prefix), ", ")
+}
+
+// appendRejectingBinding appends binding to the existing list, keeping only the most
+// recent maxReportedRejectingBindings entries. If binding is already present it is moved
+// to the end instead of duplicated, so that a binding the scheduler keeps retrying doesn't
+// crowd out every other rejection reported for this cluster.
+func appendRejectingBinding(existing []string, binding types.NamespacedName) []string {
+	key := binding.String()
+
+	deduped := make([]string, 0, len(existing)+1)
+	for _, b := range existing {
+		if b != key {
+			deduped = append(deduped, b)
+		}
+	}
+	deduped = append(deduped, key)
+
+	if len(deduped) > maxReportedRejectingBindings {
+		deduped = deduped[len(deduped)-maxReportedRejectingBindings:]
+	}
+	return deduped
+}
@@ -0,0 +1,92 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+)
+
+func TestRecordFilteredOut(t *testing.T) {
+	clusterName := "member1"
+	cluster := &v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName}}
+	c := newTestController(t, cluster, nil, nil)
+
+	binding := types.NamespacedName{Namespace: "test", Name: "nginx"}
+	if err := c.RecordFilteredOut(clusterName, v1alpha1.ClusterNotSchedulableReasonTaintToleration, binding); err != nil {
+		t.Fatalf("RecordFilteredOut() returned error: %v", err)
+	}
+
+	updated := &v1alpha1.Cluster{}
+	if err := c.Client.Get(context.TODO(), types.NamespacedName{Name: clusterName}, updated); err != nil {
+		t.Fatalf("failed to get cluster after RecordFilteredOut: %v", err)
+	}
+
+	condition := meta.FindStatusCondition(updated.Status.Conditions, v1alpha1.ClusterConditionSchedulable)
+	if condition == nil {
+		t.Fatalf("expected Schedulable condition to be set")
+	}
+	if condition.Reason != v1alpha1.ClusterNotSchedulableReasonTaintToleration {
+		t.Fatalf("expected reason %s, got %s", v1alpha1.ClusterNotSchedulableReasonTaintToleration, condition.Reason)
+	}
+}
+
+func TestRecordFilteredOut_NoopWhenUnchanged(t *testing.T) {
+	clusterName := "member1"
+	cluster := &v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName}}
+	c := newTestController(t, cluster, nil, nil)
+
+	binding := types.NamespacedName{Namespace: "test", Name: "nginx"}
+	if err := c.RecordFilteredOut(clusterName, v1alpha1.ClusterNotSchedulableReasonTaintToleration, binding); err != nil {
+		t.Fatalf("RecordFilteredOut() returned error: %v", err)
+	}
+
+	recorder := c.EventRecorder.(*record.FakeRecorder)
+	<-recorder.Events // drain the FilteredOut event emitted by the first call
+
+	updatedBefore := &v1alpha1.Cluster{}
+	if err := c.Client.Get(context.TODO(), types.NamespacedName{Name: clusterName}, updatedBefore); err != nil {
+		t.Fatalf("failed to get cluster: %v", err)
+	}
+	generationBefore := updatedBefore.ResourceVersion
+
+	if err := c.RecordFilteredOut(clusterName, v1alpha1.ClusterNotSchedulableReasonTaintToleration, binding); err != nil {
+		t.Fatalf("RecordFilteredOut() returned error on repeat call: %v", err)
+	}
+
+	select {
+	case ev := <-recorder.Events:
+		t.Fatalf("expected no event on a repeat filter with the same reason, got %q", ev)
+	default:
+	}
+
+	updatedAfter := &v1alpha1.Cluster{}
+	if err := c.Client.Get(context.TODO(), types.NamespacedName{Name: clusterName}, updatedAfter); err != nil {
+		t.Fatalf("failed to get cluster: %v", err)
+	}
+	if updatedAfter.ResourceVersion != generationBefore {
+		t.Fatalf("expected no Status().Update() on a repeat filter with the same reason, resourceVersion changed from %s to %s", generationBefore, updatedAfter.ResourceVersion)
+	}
+}
+
+func TestAppendRejectingBindingDeduplicates(t *testing.T) {
+	repeated := types.NamespacedName{Namespace: "test", Name: "nginx"}
+	other := types.NamespacedName{Namespace: "test", Name: "redis"}
+
+	var rejecting []string
+	rejecting = appendRejectingBinding(rejecting, repeated)
+	rejecting = appendRejectingBinding(rejecting, other)
+	rejecting = appendRejectingBinding(rejecting, repeated)
+
+	if len(rejecting) != 2 {
+		t.Fatalf("expected repeated rejections of the same binding not to duplicate, got %v", rejecting)
+	}
+	if rejecting[len(rejecting)-1] != repeated.String() {
+		t.Fatalf("expected the most recently rejected binding to be last, got %v", rejecting)
+	}
+}
@@ -0,0 +1,356 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// ExecutionSpaceProvider abstracts where and how a member cluster's execution space --
+// the place Work objects targeting it live -- is hosted. The default NamespaceProvider
+// keeps the historical one-namespace-per-member-cluster model, but control planes
+// managing hundreds of clusters can select RemoteProvider or VClusterProvider, via
+// Cluster.Spec.ExecutionSpace.Mode, to keep the control plane's own namespace/Work
+// storage from becoming an etcd hotspot.
+type ExecutionSpaceProvider interface {
+	// Ensure makes sure cluster's execution space exists, creating it if necessary.
+	Ensure(cluster *v1alpha1.Cluster) error
+	// Remove deletes cluster's execution space.
+	Remove(cluster *v1alpha1.Cluster) error
+	// Exists reports whether cluster's execution space is still present.
+	Exists(cluster *v1alpha1.Cluster) (bool, error)
+}
+
+// ExecutionSpaceForceRemover is an optional capability an ExecutionSpaceProvider can
+// implement when it supports forcibly stripping leftover finalizers from an execution
+// space that never finishes terminating gracefully. The cluster controller type-asserts
+// for it rather than requiring every provider to implement force-removal semantics that
+// may not even apply to their backend.
+type ExecutionSpaceForceRemover interface {
+	// ForceRemove strips the finalizers from cluster's stuck execution space (and
+	// whatever it holds) so that it can finish terminating.
+	ForceRemove(cluster *v1alpha1.Cluster) error
+}
+
+// executionSpaceProviderFor selects the ExecutionSpaceProvider backing cluster's
+// execution space, defaulting to NamespaceProvider when Spec.ExecutionSpace is unset.
+func (c *Controller) executionSpaceProviderFor(cluster *v1alpha1.Cluster) ExecutionSpaceProvider {
+	mode := v1alpha1.ExecutionSpaceModeNamespace
+	if cluster.Spec.ExecutionSpace != nil && cluster.Spec.ExecutionSpace.Mode != "" {
+		mode = cluster.Spec.ExecutionSpace.Mode
+	}
+
+	switch mode {
+	case v1alpha1.ExecutionSpaceModeRemote:
+		return &RemoteProvider{KubeClientSet: c.KubeClientSet}
+	case v1alpha1.ExecutionSpaceModeVCluster:
+		return &VClusterProvider{}
+	default:
+		return &NamespaceProvider{KubeClientSet: c.KubeClientSet, Client: c.Client, EventRecorder: c.EventRecorder}
+	}
+}
+
+// NamespaceProvider hosts a cluster's execution space as a namespace inside the karmada
+// control plane -- the original, still-default behavior.
+type NamespaceProvider struct {
+	KubeClientSet kubernetes.Interface
+	Client        client.Client
+	EventRecorder record.EventRecorder
+}
+
+// Ensure implements ExecutionSpaceProvider.
+func (p *NamespaceProvider) Ensure(cluster *v1alpha1.Cluster) error {
+	executionSpace, err := names.GenerateExecutionSpaceName(cluster.Name)
+	if err != nil {
+		klog.Errorf("Failed to generate execution space name for member cluster %s, err is %v", cluster.Name, err)
+		return err
+	}
+
+	_, err = p.KubeClientSet.CoreV1().Namespaces().Get(context.TODO(), executionSpace, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		klog.Errorf("Could not get %s namespace: %v", executionSpace, err)
+		return err
+	}
+
+	clusterES := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   executionSpace,
+			Labels: map[string]string{executionSpaceLabelKey: executionSpaceLabelValue},
+		},
+	}
+	if _, err := p.KubeClientSet.CoreV1().Namespaces().Create(context.TODO(), clusterES, metav1.CreateOptions{}); err != nil {
+		klog.Errorf("Failed to create execution space for cluster %v", cluster.Name)
+		return err
+	}
+	return nil
+}
+
+// Remove implements ExecutionSpaceProvider.
+func (p *NamespaceProvider) Remove(cluster *v1alpha1.Cluster) error {
+	executionSpace, err := names.GenerateExecutionSpaceName(cluster.Name)
+	if err != nil {
+		klog.Errorf("Failed to generate execution space name for member cluster %s, err is %v", cluster.Name, err)
+		return err
+	}
+
+	deleteOptions := metav1.DeleteOptions{}
+	if preserveResourcesOnDeletion(cluster) {
+		if err := p.markWorksPreserveResources(executionSpace); err != nil {
+			return err
+		}
+		orphan := metav1.DeletePropagationOrphan
+		deleteOptions.PropagationPolicy = &orphan
+	}
+
+	if err := p.KubeClientSet.CoreV1().Namespaces().Delete(context.TODO(), executionSpace, deleteOptions); err != nil {
+		klog.Errorf("Error while deleting namespace %s: %s", executionSpace, err)
+		return err
+	}
+	return nil
+}
+
+// Exists implements ExecutionSpaceProvider.
+func (p *NamespaceProvider) Exists(cluster *v1alpha1.Cluster) (bool, error) {
+	executionSpace, err := names.GenerateExecutionSpaceName(cluster.Name)
+	if err != nil {
+		klog.Errorf("Failed to generate execution space name for member cluster %s, err is %v", cluster.Name, err)
+		return false, err
+	}
+
+	_, err = p.KubeClientSet.CoreV1().Namespaces().Get(context.TODO(), executionSpace, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		klog.Errorf("Failed to get execution space %v, err is %v ", executionSpace, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// ForceRemove implements ExecutionSpaceForceRemover. It strips the finalizers from every
+// Work in the cluster's stuck execution space, deletes the namespace itself and then
+// strips the namespace's own finalizers if it is still present, unblocking clusters whose execution space never finishes terminating because its Works
+// are stuck doing so. When preserveResourcesOnDeletion(cluster) is true, it marks every
+// Work to preserve its propagated resources (exactly like the graceful Remove path) before
+// stripping anything, and orphans the namespace delete, so that forcing a stuck deletion
+// through still honors Spec.PreserveResourcesOnDeletion instead of silently overriding it.
+func (p *NamespaceProvider) ForceRemove(cluster *v1alpha1.Cluster) error {
+	executionSpace, err := names.GenerateExecutionSpaceName(cluster.Name)
+	if err != nil {
+		klog.Errorf("Failed to generate execution space name for member cluster %s, err is %v", cluster.Name, err)
+		return err
+	}
+
+	preserve := preserveResourcesOnDeletion(cluster)
+	if preserve {
+		if err := p.markWorksPreserveResources(executionSpace); err != nil {
+			return err
+		}
+	}
+
+	workList := &workv1alpha1.WorkList{}
+	if err := p.Client.List(context.TODO(), workList, client.InNamespace(executionSpace)); err != nil {
+		klog.Errorf("Failed to list works in execution space %s: %v", executionSpace, err)
+		return err
+	}
+
+	for i := range workList.Items {
+		work := &workList.Items[i]
+		if len(work.Finalizers) == 0 {
+			continue
+		}
+
+		removedFinalizers := work.Finalizers
+		patch := client.MergeFrom(work.DeepCopy())
+		work.Finalizers = nil
+		if err := p.Client.Patch(context.TODO(), work, patch); err != nil && !apierrors.IsNotFound(err) {
+			klog.Errorf("Failed to strip finalizers from work %s/%s: %v", work.Namespace, work.Name, err)
+			return err
+		}
+		p.EventRecorder.Eventf(cluster, corev1.EventTypeWarning, "ForcedFinalizerRemoval",
+			"Force-removed finalizers %v from work %s/%s so that cluster %s could be unregistered", removedFinalizers, work.Namespace, work.Name, cluster.Name)
+	}
+
+	deleteOptions := metav1.DeleteOptions{}
+	if preserve {
+		orphan := metav1.DeletePropagationOrphan
+		deleteOptions.PropagationPolicy = &orphan
+	}
+	if err := p.KubeClientSet.CoreV1().Namespaces().Delete(context.TODO(), executionSpace, deleteOptions); err != nil && !apierrors.IsNotFound(err) {
+		klog.Errorf("Failed to force delete execution space %s: %v", executionSpace, err)
+		return err
+	}
+	if ns, err := p.KubeClientSet.CoreV1().Namespaces().Get(context.TODO(), executionSpace, metav1.GetOptions{}); err == nil && len(ns.Spec.Finalizers) > 0 {
+		removedFinalizers := ns.Spec.Finalizers
+		ns.Spec.Finalizers = nil
+		if _, err := p.KubeClientSet.CoreV1().Namespaces().Finalize(context.TODO(), ns, metav1.UpdateOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			klog.Errorf("Failed to strip finalizers from execution space %s: %v", executionSpace, err)
+			return err
+		}
+		p.EventRecorder.Eventf(cluster, corev1.EventTypeWarning, "ForcedFinalizerRemoval",
+			"Force-removed finalizers %v from execution space %s so that cluster %s could be unregistered", removedFinalizers, executionSpace, cluster.Name)
+	} else if err != nil && !apierrors.IsNotFound(err) {
+		klog.Errorf("Failed to get execution space %s: %v", executionSpace, err)
+		return err
+	}
+	p.EventRecorder.Eventf(cluster, corev1.EventTypeWarning, "ForcedNamespaceRemoval",
+		"Force-removed execution space %s for cluster %s after the force-deletion grace period elapsed", executionSpace, cluster.Name)
+
+	return nil
+}
+
+// preserveResourcesOnDeletion reports whether cluster's workloads should keep running on
+// the member cluster instead of being cascade-deleted along with the Cluster object.
+func preserveResourcesOnDeletion(cluster *v1alpha1.Cluster) bool {
+	return cluster.Spec.PreserveResourcesOnDeletion != nil && *cluster.Spec.PreserveResourcesOnDeletion
+}
+
+// markWorksPreserveResources patches every Work in the execution space so that deleting
+// it does not tear down the resources it already propagated to the member cluster.
+func (p *NamespaceProvider) markWorksPreserveResources(executionSpace string) error {
+	workList := &workv1alpha1.WorkList{}
+	if err := p.Client.List(context.TODO(), workList, client.InNamespace(executionSpace)); err != nil {
+		klog.Errorf("Failed to list works in execution space %s: %v", executionSpace, err)
+		return err
+	}
+
+	for i := range workList.Items {
+		work := &workList.Items[i]
+		if work.Spec.PreserveResourcesOnDeletion != nil && *work.Spec.PreserveResourcesOnDeletion {
+			continue
+		}
+
+		patch := client.MergeFrom(work.DeepCopy())
+		preserve := true
+		work.Spec.PreserveResourcesOnDeletion = &preserve
+		if err := p.Client.Patch(context.TODO(), work, patch); err != nil {
+			klog.Errorf("Failed to mark work %s/%s to preserve resources on deletion: %v", work.Namespace, work.Name, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// unimplementedModeError is returned by Ensure on an ExecutionSpaceProvider that exists
+// as an API value but whose implementation hasn't landed yet (VClusterProvider). The
+// cluster controller type-asserts for it so it can tell an
+// operator-actionable "this mode doesn't exist yet" apart from a transient provisioning
+// failure when reporting ClusterConditionExecutionSpaceReady.
+type unimplementedModeError struct {
+	mode    v1alpha1.ExecutionSpaceMode
+	cluster string
+}
+
+func (e *unimplementedModeError) Error() string {
+	return fmt.Sprintf("%s execution space provider is not yet implemented for cluster %s", e.mode, e.cluster)
+}
+
+// RemoteProvider hosts a cluster's execution space inside the member cluster itself,
+// instead of as a namespace in the karmada control plane, so Work objects sync locally
+// and the control plane's own etcd never sees a namespace-per-cluster hotspot. It reuses
+// the same Cluster.Spec.SecretRef credentials the rest of the control plane uses to talk
+// to the member cluster.
+type RemoteProvider struct {
+	KubeClientSet kubernetes.Interface
+}
+
+// Ensure implements ExecutionSpaceProvider.
+func (p *RemoteProvider) Ensure(cluster *v1alpha1.Cluster) error {
+	memberClient, err := p.memberKubeClient(cluster)
+	if err != nil {
+		return err
+	}
+
+	if _, err := memberClient.CoreV1().Namespaces().Get(context.TODO(), remoteExecutionSpaceName, metav1.GetOptions{}); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	clusterES := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   remoteExecutionSpaceName,
+			Labels: map[string]string{executionSpaceLabelKey: executionSpaceLabelValue},
+		},
+	}
+	_, err = memberClient.CoreV1().Namespaces().Create(context.TODO(), clusterES, metav1.CreateOptions{})
+	return err
+}
+
+// Remove implements ExecutionSpaceProvider.
+func (p *RemoteProvider) Remove(cluster *v1alpha1.Cluster) error {
+	memberClient, err := p.memberKubeClient(cluster)
+	if err != nil {
+		return err
+	}
+	return memberClient.CoreV1().Namespaces().Delete(context.TODO(), remoteExecutionSpaceName, metav1.DeleteOptions{})
+}
+
+// Exists implements ExecutionSpaceProvider.
+func (p *RemoteProvider) Exists(cluster *v1alpha1.Cluster) (bool, error) {
+	memberClient, err := p.memberKubeClient(cluster)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = memberClient.CoreV1().Namespaces().Get(context.TODO(), remoteExecutionSpaceName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// memberKubeClient resolves a kubernetes.Interface for cluster itself, via the same
+// Cluster.Spec.SecretRef credentials util.NewClusterClientSet uses elsewhere to reach
+// member clusters.
+func (p *RemoteProvider) memberKubeClient(cluster *v1alpha1.Cluster) (kubernetes.Interface, error) {
+	clusterClient, err := util.NewClusterClientSet(cluster, p.KubeClientSet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build client for member cluster %s: %v", cluster.Name, err)
+	}
+	return clusterClient.KubeClient, nil
+}
+
+// remoteExecutionSpaceName is the fixed namespace RemoteProvider uses inside every member
+// cluster; unlike NamespaceProvider's control-plane namespace it doesn't need to be
+// unique per cluster, since each member cluster has its own namespace space.
+const remoteExecutionSpaceName = "karmada-execution-space"
+
+// VClusterProvider hosts a cluster's execution space inside an isolated vcluster, for
+// tenants whose isolation requirements rule out sharing the control plane's own
+// namespaces.
+//
+// TODO: stubbed out until vcluster lifecycle management lands.
+type VClusterProvider struct{}
+
+// Ensure implements ExecutionSpaceProvider.
+func (p *VClusterProvider) Ensure(cluster *v1alpha1.Cluster) error {
+	return &unimplementedModeError{mode: v1alpha1.ExecutionSpaceModeVCluster, cluster: cluster.Name}
+}
+
+// Remove implements ExecutionSpaceProvider. Ensure never succeeds, so there's nothing to
+// remove; a no-op lets a cluster stuck in this mode still be finalized.
+func (p *VClusterProvider) Remove(cluster *v1alpha1.Cluster) error {
+	return nil
+}
+
+// Exists implements ExecutionSpaceProvider. See Remove: there is nothing to find.
+func (p *VClusterProvider) Exists(cluster *v1alpha1.Cluster) (bool, error) {
+	return false, nil
+}
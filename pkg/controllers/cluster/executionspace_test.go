@@ -0,0 +1,142 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+func TestExecutionSpaceProviderFor(t *testing.T) {
+	c := newTestController(t, &v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}}, nil, nil)
+
+	tests := []struct {
+		name string
+		mode v1alpha1.ExecutionSpaceMode
+		want interface{}
+	}{
+		{name: "unset defaults to namespace", mode: "", want: &NamespaceProvider{}},
+		{name: "namespace mode", mode: v1alpha1.ExecutionSpaceModeNamespace, want: &NamespaceProvider{}},
+		{name: "remote mode", mode: v1alpha1.ExecutionSpaceModeRemote, want: &RemoteProvider{}},
+		{name: "vcluster mode", mode: v1alpha1.ExecutionSpaceModeVCluster, want: &VClusterProvider{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}}
+			if tt.mode != "" {
+				cluster.Spec.ExecutionSpace = &v1alpha1.ExecutionSpace{Mode: tt.mode}
+			}
+
+			got := c.executionSpaceProviderFor(cluster)
+			switch tt.want.(type) {
+			case *NamespaceProvider:
+				if _, ok := got.(*NamespaceProvider); !ok {
+					t.Fatalf("expected *NamespaceProvider, got %T", got)
+				}
+			case *RemoteProvider:
+				if _, ok := got.(*RemoteProvider); !ok {
+					t.Fatalf("expected *RemoteProvider, got %T", got)
+				}
+			case *VClusterProvider:
+				if _, ok := got.(*VClusterProvider); !ok {
+					t.Fatalf("expected *VClusterProvider, got %T", got)
+				}
+			}
+		})
+	}
+}
+
+func TestVClusterProviderNotImplemented(t *testing.T) {
+	cluster := &v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}}
+	p := &VClusterProvider{}
+
+	if err := p.Ensure(cluster); err == nil {
+		t.Fatalf("expected VClusterProvider.Ensure to return an error until it is implemented")
+	}
+}
+
+func TestRemoteAndVClusterProvidersTreatRemoveAndExistsAsNoop(t *testing.T) {
+	cluster := &v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}}
+
+	for name, provider := range map[string]ExecutionSpaceProvider{
+		"remote":   &RemoteProvider{},
+		"vcluster": &VClusterProvider{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := provider.Remove(cluster); err != nil {
+				t.Fatalf("Remove() returned error: %v, want nil so that deletion is never bricked", err)
+			}
+			exists, err := provider.Exists(cluster)
+			if err != nil {
+				t.Fatalf("Exists() returned error: %v, want nil", err)
+			}
+			if exists {
+				t.Fatalf("Exists() = true, want false since nothing was ever created")
+			}
+		})
+	}
+}
+
+// RemoteProvider really manages a namespace inside the member cluster, so when that
+// cluster cannot be reached (here: its credential secret does not exist) Remove and
+// Exists report an error instead of claiming success.
+func TestRemoteProviderRemoveAndExistsFailWhenMemberClusterUnreachable(t *testing.T) {
+	cluster := &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+		Spec: v1alpha1.ClusterSpec{
+			APIEndpoint: "https://127.0.0.1:6443",
+			SecretRef:   &v1alpha1.LocalSecretReference{Namespace: "karmada-cluster", Name: "member1"},
+		},
+	}
+	c := newTestController(t, cluster, nil, nil)
+	provider := &RemoteProvider{KubeClientSet: c.KubeClientSet}
+
+	if err := provider.Remove(cluster); err == nil {
+		t.Fatalf("Remove() returned nil, want an error since the member cluster cannot be reached")
+	}
+	if _, err := provider.Exists(cluster); err == nil {
+		t.Fatalf("Exists() returned nil error, want an error since the member cluster cannot be reached")
+	}
+}
+
+func TestNamespaceProviderForceRemove(t *testing.T) {
+	clusterName := "member1"
+	executionSpace, err := names.GenerateExecutionSpaceName(clusterName)
+	if err != nil {
+		t.Fatalf("failed to generate execution space name: %v", err)
+	}
+
+	cluster := &v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName}}
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: executionSpace}}
+	work := &workv1alpha1.Work{ObjectMeta: metav1.ObjectMeta{Name: "work1", Namespace: executionSpace, Finalizers: []string{"karmada.io/stuck-finalizer"}}}
+
+	c := newTestController(t, cluster, []*workv1alpha1.Work{work}, namespace)
+	provider := c.executionSpaceProviderFor(cluster)
+
+	forceRemover, ok := provider.(ExecutionSpaceForceRemover)
+	if !ok {
+		t.Fatalf("expected NamespaceProvider to implement ExecutionSpaceForceRemover")
+	}
+	if err := forceRemover.ForceRemove(cluster); err != nil {
+		t.Fatalf("ForceRemove() returned error: %v", err)
+	}
+
+	updatedWork := &workv1alpha1.Work{}
+	if err := c.Client.Get(context.TODO(), client.ObjectKey{Namespace: executionSpace, Name: "work1"}, updatedWork); err != nil {
+		t.Fatalf("failed to get work after ForceRemove: %v", err)
+	}
+	if len(updatedWork.Finalizers) != 0 {
+		t.Fatalf("expected work finalizers to be stripped, got %v", updatedWork.Finalizers)
+	}
+
+	if _, err := c.KubeClientSet.CoreV1().Namespaces().Get(context.TODO(), executionSpace, metav1.GetOptions{}); err == nil {
+		t.Fatalf("expected execution space %s to be deleted", executionSpace)
+	}
+}
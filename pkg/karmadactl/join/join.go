@@ -0,0 +1,182 @@
+package join
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	clusterapi "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+// clusterSecretNamespace is the namespace in the karmada control plane that holds the
+// credential secrets join creates for each member cluster.
+const clusterSecretNamespace = "karmada-cluster"
+
+var (
+	joinShort = `Register a member cluster with the Karmada control plane`
+
+	joinLong = `Register a cluster with the Karmada control plane so resources can be propagated to it.`
+
+	joinExample = `
+# Join a member cluster
+%[1]s join CLUSTER_NAME --cluster-kubeconfig=/path/to/member.config
+`
+)
+
+// CommandJoinOption holds the command-line options for the 'join' subcommand.
+type CommandJoinOption struct {
+	// KubeConfig is the path of the karmada control plane kubeconfig.
+	KubeConfig string
+	// KarmadaContext is the context of the control plane kubeconfig to use.
+	KarmadaContext string
+
+	// ClusterName is the cluster's name to register it under in the karmada control plane.
+	ClusterName string
+	// ClusterKubeConfig is the member cluster's kubeconfig, used to read its credentials.
+	ClusterKubeConfig string
+	// ClusterContext is the context in ClusterKubeConfig to use.
+	ClusterContext string
+}
+
+// NewCmdJoin defines the `join` command that registers a member cluster with the Karmada
+// control plane.
+func NewCmdJoin(cmdOut io.Writer, karmadaConfig string, parentCommand string) *cobra.Command {
+	opts := CommandJoinOption{KubeConfig: karmadaConfig}
+
+	cmd := &cobra.Command{
+		Use:     "join CLUSTER_NAME",
+		Short:   joinShort,
+		Long:    joinLong,
+		Example: fmt.Sprintf(joinExample, parentCommand),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := opts.Complete(args); err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Run(cmdOut)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.KarmadaContext, "karmada-context", "", "Name of the context in control-plane kubeconfig to use")
+	flags.StringVar(&opts.ClusterKubeConfig, "cluster-kubeconfig", "", "Path of the member cluster's kubeconfig")
+	flags.StringVar(&opts.ClusterContext, "cluster-context", "", "Context name of cluster-kubeconfig")
+
+	return cmd
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *CommandJoinOption) Complete(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("cluster name is required")
+	}
+	o.ClusterName = args[0]
+	return nil
+}
+
+// Validate checks that the provided options are sane.
+func (o *CommandJoinOption) Validate() error {
+	if o.ClusterName == "" {
+		return fmt.Errorf("cluster name can not be empty")
+	}
+	if o.ClusterKubeConfig == "" {
+		return fmt.Errorf("--cluster-kubeconfig is required")
+	}
+	return nil
+}
+
+// Run is the implementation of the 'join' command.
+func (o *CommandJoinOption) Run(cmdOut io.Writer) error {
+	restConfig, err := clientcmd.BuildConfigFromFlags(o.KarmadaContext, o.KubeConfig)
+	if err != nil {
+		return fmt.Errorf("failed to build karmada control plane config: %v", err)
+	}
+
+	karmadaClient, err := karmadaclientset.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to build karmada client: %v", err)
+	}
+
+	controlPlaneClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to build control plane client: %v", err)
+	}
+
+	memberConfig, err := util.BuildMemberClusterConfig(util.ClusterInfo{
+		Name:       o.ClusterName,
+		KubeConfig: o.ClusterKubeConfig,
+		Context:    o.ClusterContext,
+	})
+	if err != nil {
+		return err
+	}
+
+	secretRef, err := o.ensureClusterSecret(controlPlaneClient, memberConfig)
+	if err != nil {
+		return fmt.Errorf("failed to persist credentials for cluster %q: %v", o.ClusterName, err)
+	}
+
+	cluster := &clusterapi.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: o.ClusterName},
+		Spec:       clusterapi.ClusterSpec{APIEndpoint: memberConfig.Host, SecretRef: secretRef},
+	}
+
+	if _, err := karmadaClient.ClusterV1alpha1().Clusters().Create(context.TODO(), cluster, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to register cluster %q: %v", o.ClusterName, err)
+		}
+	}
+
+	fmt.Fprintf(cmdOut, "cluster(%s) is joining.\n", o.ClusterName)
+	return nil
+}
+
+// ensureClusterSecret persists memberConfig's credentials (its bearer token or client
+// certificate, plus its CA bundle) as a Secret in the karmada control plane, creating it
+// if absent or updating it in place on a re-join, and returns the LocalSecretReference the
+// Cluster object should point at. util.NewClusterClientSet resolves a member cluster's
+// client through this same Secret, so without it the joined Cluster would be unusable.
+func (o *CommandJoinOption) ensureClusterSecret(controlPlaneClient kubernetes.Interface, memberConfig *rest.Config) (*clusterapi.LocalSecretReference, error) {
+	data := map[string][]byte{}
+	if len(memberConfig.CAData) > 0 {
+		data["caBundle"] = memberConfig.CAData
+	}
+	if memberConfig.BearerToken != "" {
+		data["token"] = []byte(memberConfig.BearerToken)
+	}
+	if len(memberConfig.CertData) > 0 {
+		data["cert"] = memberConfig.CertData
+	}
+	if len(memberConfig.KeyData) > 0 {
+		data["key"] = memberConfig.KeyData
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: o.ClusterName, Namespace: clusterSecretNamespace},
+		Data:       data,
+	}
+
+	secrets := controlPlaneClient.CoreV1().Secrets(clusterSecretNamespace)
+	if _, err := secrets.Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return nil, err
+		}
+		if _, err := secrets.Update(context.TODO(), secret, metav1.UpdateOptions{}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &clusterapi.LocalSecretReference{Namespace: clusterSecretNamespace, Name: o.ClusterName}, nil
+}
@@ -0,0 +1,115 @@
+package unjoin
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/clientcmd"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+var (
+	unjoinShort = `Remove a member cluster from the Karmada control plane`
+
+	unjoinLong = `Remove a member cluster that was previously joined to the Karmada control plane.`
+
+	unjoinExample = `
+# Unjoin a member cluster
+%[1]s unjoin CLUSTER_NAME
+`
+)
+
+// CommandUnjoinOption holds the command-line options for the 'unjoin' subcommand.
+type CommandUnjoinOption struct {
+	// KubeConfig is the path of the karmada control plane kubeconfig.
+	KubeConfig string
+	// KarmadaContext is the context of the control plane kubeconfig to use.
+	KarmadaContext string
+
+	// ClusterName is the cluster's name that is going to be unjoined.
+	ClusterName string
+
+	// PreserveResourcesOnDeletion, when true, keeps the resources already propagated to
+	// the member cluster running instead of cascade-deleting them along with the
+	// execution space.
+	PreserveResourcesOnDeletion bool
+}
+
+// NewCmdUnjoin defines the `unjoin` command that removes registration of a cluster from
+// the Karmada control plane.
+func NewCmdUnjoin(cmdOut io.Writer, karmadaConfig string, parentCommand string) *cobra.Command {
+	opts := CommandUnjoinOption{KubeConfig: karmadaConfig}
+
+	cmd := &cobra.Command{
+		Use:     "unjoin CLUSTER_NAME",
+		Short:   unjoinShort,
+		Long:    unjoinLong,
+		Example: fmt.Sprintf(unjoinExample, parentCommand),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := opts.Complete(args); err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Run(cmdOut)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.KarmadaContext, "karmada-context", "", "Name of the context in control-plane kubeconfig to use")
+	flags.BoolVar(&opts.PreserveResourcesOnDeletion, "preserve-resources-on-deletion", false, "Keep resources already propagated to the member cluster running instead of deleting them along with the execution space")
+
+	return cmd
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *CommandUnjoinOption) Complete(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("cluster name is required")
+	}
+	o.ClusterName = args[0]
+	return nil
+}
+
+// Validate checks that the provided options are sane.
+func (o *CommandUnjoinOption) Validate() error {
+	if o.ClusterName == "" {
+		return fmt.Errorf("cluster name can not be empty")
+	}
+	return nil
+}
+
+// Run is the implementation of the 'unjoin' command.
+func (o *CommandUnjoinOption) Run(cmdOut io.Writer) error {
+	restConfig, err := clientcmd.BuildConfigFromFlags(o.KarmadaContext, o.KubeConfig)
+	if err != nil {
+		return fmt.Errorf("failed to build karmada control plane config: %v", err)
+	}
+
+	karmadaClient, err := karmadaclientset.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to build karmada client: %v", err)
+	}
+
+	if _, err := util.GetClusterWithKarmadaClient(karmadaClient, o.ClusterName); err != nil {
+		return err
+	}
+
+	if o.PreserveResourcesOnDeletion {
+		if err := util.SetPreserveResourcesOnDeletion(karmadaClient, o.ClusterName, true); err != nil {
+			return err
+		}
+	}
+
+	if err := util.DeleteClusterObject(karmadaClient, o.ClusterName); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to unjoin cluster %q: %v", o.ClusterName, err)
+	}
+
+	fmt.Fprintf(cmdOut, "cluster(%s) is unjoining.\n", o.ClusterName)
+	return nil
+}
@@ -0,0 +1,198 @@
+package unregister
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+const (
+	// karmadaAgentNamespace is the namespace the karmada-agent bootstrap secret lives in
+	// on the member cluster side.
+	karmadaAgentNamespace = "karmada-system"
+	// karmadaAgentBootstrapSecretName is the secret the member cluster's karmada-agent
+	// uses to authenticate against the karmada control plane.
+	karmadaAgentBootstrapSecretName = "karmada-agent-bootstrap"
+)
+
+var (
+	unregisterShort = `Unregister a member cluster from the Karmada control plane`
+
+	unregisterLong = `Unregister a member cluster that was previously joined to the Karmada control plane.
+Unlike deleting the Cluster object directly, this command can also remove the
+karmada-agent bootstrap secret from the member cluster, and, when --force is set, asks the
+cluster controller to strip leftover finalizers from this cluster's execution space (and
+the Works inside it) once it has stayed in Terminating state for longer than the
+controller-manager's force-deletion grace period. --force only takes effect if the
+controller-manager was itself started with force-deletion capability enabled; otherwise
+the cluster is marked but never force-removed.`
+
+	unregisterExample = `
+# Unregister a member cluster
+%[1]s unregister CLUSTER_NAME
+
+# Unregister a member cluster stuck terminating, also cleaning up the member cluster side
+%[1]s unregister CLUSTER_NAME --cluster-kubeconfig=/path/to/member.config --force
+`
+)
+
+// CommandUnregisterOption holds the command-line options for the 'unregister' subcommand.
+type CommandUnregisterOption struct {
+	// KubeConfig is the path of the karmada control plane kubeconfig.
+	KubeConfig string
+	// KarmadaContext is the context of the control plane kubeconfig to use.
+	KarmadaContext string
+
+	// ClusterName is the cluster's name that is going to be unregistered.
+	ClusterName string
+	// ClusterKubeConfig is the member cluster's kubeconfig, used to remove the
+	// karmada-agent bootstrap secret from that side. Optional.
+	ClusterKubeConfig string
+	// ClusterContext is the context in ClusterKubeConfig to use.
+	ClusterContext string
+
+	// Force, when set, persists Cluster.Spec.ForceDeletion so the cluster controller
+	// knows this cluster (and only this cluster) has asked to have finalizers stripped
+	// from its execution space and its Works once it has been terminating for longer
+	// than the controller-manager's force-deletion grace period, instead of waiting for
+	// it indefinitely. Only takes effect if the controller-manager was started with
+	// force-deletion capability enabled.
+	Force bool
+
+	// PreserveResourcesOnDeletion, when true, keeps the resources already propagated to
+	// the member cluster running instead of cascade-deleting them along with the
+	// execution space.
+	PreserveResourcesOnDeletion bool
+}
+
+// NewCmdUnregister defines the `unregister` command that removes registration of a member
+// cluster from the Karmada control plane.
+func NewCmdUnregister(cmdOut io.Writer, karmadaConfig string, parentCommand string) *cobra.Command {
+	opts := CommandUnregisterOption{KubeConfig: karmadaConfig}
+
+	cmd := &cobra.Command{
+		Use:     "unregister CLUSTER_NAME",
+		Short:   unregisterShort,
+		Long:    unregisterLong,
+		Example: fmt.Sprintf(unregisterExample, parentCommand),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := opts.Complete(args); err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Run(cmdOut)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.KarmadaContext, "karmada-context", "", "Name of the context in control-plane kubeconfig to use")
+	flags.StringVar(&opts.ClusterKubeConfig, "cluster-kubeconfig", "", "Path of the member cluster's kubeconfig, used to remove the karmada-agent bootstrap secret")
+	flags.StringVar(&opts.ClusterContext, "cluster-context", "", "Context name of cluster-kubeconfig")
+	flags.BoolVar(&opts.Force, "force", false, "Ask the cluster controller to strip leftover finalizers from the execution space and its Works once this cluster has been terminating too long. Only takes effect if the controller-manager has force-deletion capability enabled")
+	flags.BoolVar(&opts.PreserveResourcesOnDeletion, "preserve-resources-on-deletion", false, "Keep resources already propagated to the member cluster running instead of deleting them along with the execution space")
+
+	return cmd
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *CommandUnregisterOption) Complete(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("cluster name is required")
+	}
+	o.ClusterName = args[0]
+	return nil
+}
+
+// Validate checks that the provided options are sane.
+func (o *CommandUnregisterOption) Validate() error {
+	if o.ClusterName == "" {
+		return fmt.Errorf("cluster name can not be empty")
+	}
+	if o.ClusterContext != "" && o.ClusterKubeConfig == "" {
+		return fmt.Errorf("--cluster-context requires --cluster-kubeconfig to be set")
+	}
+	return nil
+}
+
+// Run is the implementation of the 'unregister' command.
+func (o *CommandUnregisterOption) Run(cmdOut io.Writer) error {
+	restConfig, err := clientcmd.BuildConfigFromFlags(o.KarmadaContext, o.KubeConfig)
+	if err != nil {
+		return fmt.Errorf("failed to build karmada control plane config: %v", err)
+	}
+
+	karmadaClient, err := karmadaclientset.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to build karmada client: %v", err)
+	}
+
+	if _, err := util.GetClusterWithKarmadaClient(karmadaClient, o.ClusterName); err != nil {
+		return err
+	}
+
+	if o.ClusterKubeConfig != "" {
+		if err := o.deleteBootstrapSecret(); err != nil {
+			// The member cluster may already be unreachable; don't block unregistration on it.
+			klog.Errorf("Failed to remove karmada-agent bootstrap secret from cluster %s: %v", o.ClusterName, err)
+		}
+	}
+
+	if o.PreserveResourcesOnDeletion {
+		if err := util.SetPreserveResourcesOnDeletion(karmadaClient, o.ClusterName, true); err != nil {
+			return err
+		}
+	}
+
+	if o.Force {
+		if err := util.SetForceDeletion(karmadaClient, o.ClusterName, true); err != nil {
+			return err
+		}
+	}
+
+	if err := util.DeleteClusterObject(karmadaClient, o.ClusterName); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to unregister cluster %q: %v", o.ClusterName, err)
+	}
+
+	if o.Force {
+		fmt.Fprintf(cmdOut, "cluster(%s) is unregistering; its execution space will be force-removed once the controller-manager's force-deletion grace period has elapsed, provided the controller-manager has force-deletion capability enabled.\n", o.ClusterName)
+	} else {
+		fmt.Fprintf(cmdOut, "cluster(%s) is unregistering.\n", o.ClusterName)
+	}
+	return nil
+}
+
+// deleteBootstrapSecret removes the karmada-agent bootstrap secret from the member
+// cluster side.
+func (o *CommandUnregisterOption) deleteBootstrapSecret() error {
+	memberConfig, err := util.BuildMemberClusterConfig(util.ClusterInfo{
+		Name:       o.ClusterName,
+		KubeConfig: o.ClusterKubeConfig,
+		Context:    o.ClusterContext,
+	})
+	if err != nil {
+		return err
+	}
+
+	memberClient, err := kubernetes.NewForConfig(memberConfig)
+	if err != nil {
+		return err
+	}
+
+	err = memberClient.CoreV1().Secrets(karmadaAgentNamespace).Delete(context.TODO(), karmadaAgentBootstrapSecretName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package util
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+)
+
+// ClusterInfo groups the pieces of information a karmadactl cluster-lifecycle subcommand
+// (join/unregister) needs in order to reach the member cluster it operates on.
+type ClusterInfo struct {
+	// Name is the Cluster object's name in the karmada control plane.
+	Name string
+	// KubeConfig is the path to the member cluster's kubeconfig, used by join to read the
+	// member cluster's credentials and by unregister to clean up resources left behind on
+	// the member cluster side, such as the karmada-agent bootstrap secret.
+	KubeConfig string
+	// Context is the kubeconfig context to use when talking to the member cluster.
+	Context string
+}
+
+// BuildMemberClusterConfig loads a *rest.Config for the member cluster described by info.
+func BuildMemberClusterConfig(info ClusterInfo) (*rest.Config, error) {
+	if info.KubeConfig == "" {
+		return nil, fmt.Errorf("--cluster-kubeconfig is required to reach member cluster %q", info.Name)
+	}
+
+	loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: info.KubeConfig}
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: info.Context}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+}
+
+// GetClusterWithKarmadaClient fetches the named Cluster object from the karmada control
+// plane, wrapping the error so cluster-lifecycle subcommands can fail with a clear message.
+func GetClusterWithKarmadaClient(karmadaClient karmadaclientset.Interface, name string) (*v1alpha1.Cluster, error) {
+	cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster %q: %v", name, err)
+	}
+	return cluster, nil
+}
+
+// DeleteClusterObject removes the Cluster object from the karmada control plane, letting
+// the cluster controller's finalizer drive execution-space cleanup.
+func DeleteClusterObject(karmadaClient karmadaclientset.Interface, name string) error {
+	return karmadaClient.ClusterV1alpha1().Clusters().Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// SetPreserveResourcesOnDeletion updates the Cluster's PreserveResourcesOnDeletion field so
+// the cluster controller knows whether to orphan the execution space (and its Works) on
+// removal instead of cascade-deleting the workloads it propagated to the member cluster.
+func SetPreserveResourcesOnDeletion(karmadaClient karmadaclientset.Interface, name string, preserve bool) error {
+	cluster, err := GetClusterWithKarmadaClient(karmadaClient, name)
+	if err != nil {
+		return err
+	}
+
+	cluster.Spec.PreserveResourcesOnDeletion = &preserve
+	_, err = karmadaClient.ClusterV1alpha1().Clusters().Update(context.TODO(), cluster, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update cluster %q: %v", name, err)
+	}
+	return nil
+}
+
+// SetForceDeletion updates the Cluster's ForceDeletion field so the cluster controller
+// knows this specific cluster has asked to have its execution space force-removed once it
+// has been terminating for longer than the controller's grace period, instead of relying
+// solely on a controller-manager-wide toggle that would apply to every cluster.
+func SetForceDeletion(karmadaClient karmadaclientset.Interface, name string, force bool) error {
+	cluster, err := GetClusterWithKarmadaClient(karmadaClient, name)
+	if err != nil {
+		return err
+	}
+
+	cluster.Spec.ForceDeletion = &force
+	_, err = karmadaClient.ClusterV1alpha1().Clusters().Update(context.TODO(), cluster, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update cluster %q: %v", name, err)
+	}
+	return nil
+}
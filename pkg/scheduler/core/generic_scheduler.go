@@ -0,0 +1,66 @@
+package core
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// FilterPlugin decides whether a cluster is a feasible placement target for a
+// ResourceBinding. ok is false when the cluster is rejected, in which case reason should be
+// one of the ClusterNotSchedulableReason* constants describing why.
+type FilterPlugin interface {
+	Name() string
+	Filter(binding *workv1alpha2.ResourceBinding, cluster *clusterv1alpha1.Cluster) (reason string, ok bool)
+}
+
+// ClusterDiagnosisReporter records why a cluster was filtered out of a scheduling cycle, so
+// the rejection is visible on the Cluster object itself instead of only in klog. Implemented
+// by *clustercontroller.Controller; declared narrowly here so this package doesn't need to
+// import the full cluster controller.
+type ClusterDiagnosisReporter interface {
+	RecordFilteredOut(clusterName, reason string, binding types.NamespacedName) error
+}
+
+// GenericScheduler runs filter plugins against candidate clusters for a ResourceBinding,
+// narrowing them down to the feasible set.
+type GenericScheduler struct {
+	FilterPlugins     []FilterPlugin
+	DiagnosisReporter ClusterDiagnosisReporter
+}
+
+// RunFilterPlugins filters clusters down to those every plugin accepts for binding,
+// reporting each rejection to DiagnosisReporter (when set) so it surfaces on the rejected
+// cluster's Schedulable condition and as a FilteredOut event.
+func (s *GenericScheduler) RunFilterPlugins(binding *workv1alpha2.ResourceBinding, clusters []*clusterv1alpha1.Cluster) []*clusterv1alpha1.Cluster {
+	bindingKey := types.NamespacedName{Namespace: binding.Namespace, Name: binding.Name}
+
+	feasible := make([]*clusterv1alpha1.Cluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		reason, ok := s.filter(binding, cluster)
+		if ok {
+			feasible = append(feasible, cluster)
+			continue
+		}
+
+		if s.DiagnosisReporter == nil {
+			continue
+		}
+		if err := s.DiagnosisReporter.RecordFilteredOut(cluster.Name, reason, bindingKey); err != nil {
+			klog.Errorf("Failed to record filter diagnosis for cluster %s: %v", cluster.Name, err)
+		}
+	}
+	return feasible
+}
+
+// filter runs every plugin against cluster in order, stopping at the first rejection.
+func (s *GenericScheduler) filter(binding *workv1alpha2.ResourceBinding, cluster *clusterv1alpha1.Cluster) (reason string, ok bool) {
+	for _, plugin := range s.FilterPlugins {
+		if reason, ok := plugin.Filter(binding, cluster); !ok {
+			return reason, false
+		}
+	}
+	return "", true
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+type rejectByNamePlugin struct {
+	rejectedClusterName string
+	reason              string
+}
+
+func (p *rejectByNamePlugin) Name() string { return "rejectByName" }
+
+func (p *rejectByNamePlugin) Filter(_ *workv1alpha2.ResourceBinding, cluster *clusterv1alpha1.Cluster) (string, bool) {
+	if cluster.Name == p.rejectedClusterName {
+		return p.reason, false
+	}
+	return "", true
+}
+
+type fakeDiagnosisReporter struct {
+	calls []types.NamespacedName
+}
+
+func (f *fakeDiagnosisReporter) RecordFilteredOut(_, _ string, binding types.NamespacedName) error {
+	f.calls = append(f.calls, binding)
+	return nil
+}
+
+func TestGenericSchedulerRunFilterPlugins(t *testing.T) {
+	binding := &workv1alpha2.ResourceBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "nginx"}}
+	clusters := []*clusterv1alpha1.Cluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "member1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "member2"}},
+	}
+	reporter := &fakeDiagnosisReporter{}
+
+	s := &GenericScheduler{
+		FilterPlugins:     []FilterPlugin{&rejectByNamePlugin{rejectedClusterName: "member1", reason: clusterv1alpha1.ClusterNotSchedulableReasonTaintToleration}},
+		DiagnosisReporter: reporter,
+	}
+
+	feasible := s.RunFilterPlugins(binding, clusters)
+
+	if len(feasible) != 1 || feasible[0].Name != "member2" {
+		t.Fatalf("expected only member2 to be feasible, got %v", feasible)
+	}
+	if len(reporter.calls) != 1 || reporter.calls[0].Name != "nginx" {
+		t.Fatalf("expected RecordFilteredOut to be called once for the rejected binding, got %v", reporter.calls)
+	}
+}
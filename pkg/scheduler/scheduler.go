@@ -0,0 +1,75 @@
+package scheduler
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	clustercontroller "github.com/karmada-io/karmada/pkg/controllers/cluster"
+	"github.com/karmada-io/karmada/pkg/scheduler/core"
+)
+
+// Scheduler reconciles ResourceBindings, running them through GenericScheduler against
+// every known member cluster. It is the real call site that feeds RunFilterPlugins live
+// ResourceBindings and Clusters, instead of only the unit tests in pkg/scheduler/core.
+//
+// TODO: this only narrows candidate clusters down to the feasible set and reports the
+// outcome; it does not yet score feasible clusters or write a placement decision back
+// onto the ResourceBinding. That lands with the scoring/assignment plugins.
+type Scheduler struct {
+	client.Client
+	EventRecorder record.EventRecorder
+
+	// FilterPlugins are run against every candidate cluster for each ResourceBinding.
+	FilterPlugins []core.FilterPlugin
+}
+
+// Reconcile performs a full scheduling pass for the ResourceBinding referred to by the
+// Request.
+func (s *Scheduler) Reconcile(req controllerruntime.Request) (controllerruntime.Result, error) {
+	klog.V(4).Infof("Scheduling ResourceBinding %s", req.NamespacedName)
+
+	binding := &workv1alpha2.ResourceBinding{}
+	if err := s.Client.Get(context.TODO(), req.NamespacedName, binding); err != nil {
+		if errors.IsNotFound(err) {
+			return controllerruntime.Result{}, nil
+		}
+		return controllerruntime.Result{Requeue: true}, err
+	}
+
+	clusterList := &clusterv1alpha1.ClusterList{}
+	if err := s.Client.List(context.TODO(), clusterList); err != nil {
+		klog.Errorf("Failed to list clusters while scheduling ResourceBinding %s: %v", req.NamespacedName, err)
+		return controllerruntime.Result{Requeue: true}, err
+	}
+
+	candidates := make([]*clusterv1alpha1.Cluster, 0, len(clusterList.Items))
+	for i := range clusterList.Items {
+		candidates = append(candidates, &clusterList.Items[i])
+	}
+
+	scheduler := &core.GenericScheduler{
+		FilterPlugins:     s.FilterPlugins,
+		DiagnosisReporter: &clustercontroller.Controller{Client: s.Client, EventRecorder: s.EventRecorder},
+	}
+	feasible := scheduler.RunFilterPlugins(binding, candidates)
+
+	klog.V(4).Infof("ResourceBinding %s has %d feasible cluster(s) out of %d candidate(s)", req.NamespacedName, len(feasible), len(candidates))
+	if len(feasible) == 0 && len(candidates) > 0 {
+		s.EventRecorder.Eventf(binding, corev1.EventTypeWarning, "FailedScheduling", "no feasible clusters found for ResourceBinding %s", req.NamespacedName)
+	}
+
+	return controllerruntime.Result{}, nil
+}
+
+// SetupWithManager creates a controller and registers it with the controller manager.
+func (s *Scheduler) SetupWithManager(mgr controllerruntime.Manager) error {
+	return controllerruntime.NewControllerManagedBy(mgr).For(&workv1alpha2.ResourceBinding{}).Complete(s)
+}
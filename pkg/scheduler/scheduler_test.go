@@ -0,0 +1,105 @@
+package scheduler
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/core"
+)
+
+type rejectByNamePlugin struct {
+	rejectedClusterName string
+}
+
+func (p *rejectByNamePlugin) Name() string { return "rejectByName" }
+
+func (p *rejectByNamePlugin) Filter(_ *workv1alpha2.ResourceBinding, cluster *clusterv1alpha1.Cluster) (string, bool) {
+	if cluster.Name == p.rejectedClusterName {
+		return clusterv1alpha1.ClusterNotSchedulableReasonTaintToleration, false
+	}
+	return "", true
+}
+
+func newTestScheduler(t *testing.T, binding *workv1alpha2.ResourceBinding, clusters []*clusterv1alpha1.Cluster, plugins []core.FilterPlugin) *Scheduler {
+	scheme := runtime.NewScheme()
+	if err := clusterv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add clusterv1alpha1 scheme: %v", err)
+	}
+	if err := workv1alpha2.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add workv1alpha2 scheme: %v", err)
+	}
+
+	objs := []runtime.Object{binding}
+	for _, c := range clusters {
+		objs = append(objs, c)
+	}
+
+	return &Scheduler{
+		Client:        fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		EventRecorder: record.NewFakeRecorder(10),
+		FilterPlugins: plugins,
+	}
+}
+
+func TestSchedulerReconcile_FeasibleCluster(t *testing.T) {
+	binding := &workv1alpha2.ResourceBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "nginx"}}
+	clusters := []*clusterv1alpha1.Cluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "member1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "member2"}},
+	}
+	s := newTestScheduler(t, binding, clusters, []core.FilterPlugin{&rejectByNamePlugin{rejectedClusterName: "member1"}})
+
+	req := controllerruntime.Request{NamespacedName: types.NamespacedName{Namespace: "test", Name: "nginx"}}
+	if _, err := s.Reconcile(req); err != nil {
+		t.Fatalf("Reconcile() returned error: %v", err)
+	}
+
+	// member1 is still filtered out, so a FilteredOut event is expected on the same
+	// recorder; only a FailedScheduling event is a failure here.
+	recorder := s.EventRecorder.(*record.FakeRecorder)
+	for len(recorder.Events) > 0 {
+		if ev := <-recorder.Events; strings.Contains(ev, "FailedScheduling") {
+			t.Fatalf("expected no FailedScheduling event when a feasible cluster exists, got %q", ev)
+		}
+	}
+}
+
+func TestSchedulerReconcile_NoFeasibleCluster(t *testing.T) {
+	binding := &workv1alpha2.ResourceBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "nginx"}}
+	clusters := []*clusterv1alpha1.Cluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "member1"}},
+	}
+	s := newTestScheduler(t, binding, clusters, []core.FilterPlugin{&rejectByNamePlugin{rejectedClusterName: "member1"}})
+
+	req := controllerruntime.Request{NamespacedName: types.NamespacedName{Namespace: "test", Name: "nginx"}}
+	if _, err := s.Reconcile(req); err != nil {
+		t.Fatalf("Reconcile() returned error: %v", err)
+	}
+
+	recorder := s.EventRecorder.(*record.FakeRecorder)
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	if len(events) == 0 {
+		t.Fatalf("expected a FailedScheduling event when no cluster is feasible, got none")
+	}
+	found := false
+	for _, ev := range events {
+		if strings.Contains(ev, "FailedScheduling") {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected a FailedScheduling event, got %v", events)
+	}
+}
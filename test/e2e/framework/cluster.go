@@ -0,0 +1,140 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/kind/pkg/cluster"
+	"sigs.k8s.io/kind/pkg/exec"
+
+	clusterapi "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmada "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/join"
+	"github.com/karmada-io/karmada/pkg/karmadactl/unjoin"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+const (
+	// executionSpaceReadyTimeout bounds how long JoinKindCluster waits for the cluster
+	// controller to create the joined cluster's execution space.
+	executionSpaceReadyTimeout = 2 * time.Minute
+	// executionSpaceReadyInterval is the poll interval used while waiting for the
+	// execution space to show up.
+	executionSpaceReadyInterval = 2 * time.Second
+)
+
+// ClusterProvider wraps a kind cluster provider so specs can join and unjoin member
+// clusters at will, instead of being limited to the fixed set fetched at BeforeSuite.
+type ClusterProvider struct {
+	provider *cluster.Provider
+}
+
+// NewClusterProvider returns a ClusterProvider backed by a fresh kind provider.
+func NewClusterProvider() *ClusterProvider {
+	return &ClusterProvider{provider: cluster.NewProvider()}
+}
+
+// JoinKindCluster spins up a new kind cluster named clusterName, rewrites its kubeconfig
+// server address to the docker-network IP of controlPlane, registers it with the karmada
+// control plane through the karmadactl join flow, and waits for the cluster controller to
+// create its execution space. It returns the resulting Cluster object and a client set
+// for it so the caller can fold them into its own cluster bookkeeping.
+func (p *ClusterProvider) JoinKindCluster(karmadaConfig, clusterName, kubeConfigPath, controlPlane, clusterContext string, karmadaClient karmada.Interface, kubeClient kubernetes.Interface) (*clusterapi.Cluster, *util.ClusterClient, error) {
+	if err := p.provider.Create(clusterName, cluster.CreateWithKubeconfigPath(kubeConfigPath)); err != nil {
+		return nil, nil, fmt.Errorf("failed to create kind cluster %s: %v", clusterName, err)
+	}
+
+	if err := rewriteServerAddress(kubeConfigPath, clusterContext, controlPlane); err != nil {
+		return nil, nil, err
+	}
+
+	joinOpts := join.CommandJoinOption{
+		KubeConfig:        karmadaConfig,
+		ClusterName:       clusterName,
+		ClusterKubeConfig: kubeConfigPath,
+		ClusterContext:    clusterContext,
+	}
+	if err := joinOpts.Run(io.Discard); err != nil {
+		return nil, nil, fmt.Errorf("failed to join cluster %s: %v", clusterName, err)
+	}
+
+	if err := waitExecutionSpaceCreated(kubeClient, clusterName); err != nil {
+		return nil, nil, err
+	}
+
+	joined, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get joined cluster %s: %v", clusterName, err)
+	}
+
+	clusterClient, err := util.NewClusterClientSet(joined, kubeClient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build client for cluster %s: %v", clusterName, err)
+	}
+
+	return joined, clusterClient, nil
+}
+
+// UnjoinKindCluster removes clusterName from the karmada control plane through the
+// karmadactl unjoin flow and tears down its kind cluster.
+func (p *ClusterProvider) UnjoinKindCluster(karmadaConfig, clusterName, kubeConfigPath string) error {
+	unjoinOpts := unjoin.CommandUnjoinOption{KubeConfig: karmadaConfig, ClusterName: clusterName}
+	if err := unjoinOpts.Run(io.Discard); err != nil {
+		return fmt.Errorf("failed to unjoin cluster %s: %v", clusterName, err)
+	}
+
+	if err := p.provider.Delete(clusterName, kubeConfigPath); err != nil {
+		return fmt.Errorf("failed to delete kind cluster %s: %v", clusterName, err)
+	}
+	return nil
+}
+
+// rewriteServerAddress points kubeConfigPath's clusterContext entry at controlPlane's
+// docker-network IP, since kind's default kubeconfig points at localhost which isn't
+// reachable from inside the karmada control plane's containers.
+func rewriteServerAddress(kubeConfigPath, clusterContext, controlPlane string) error {
+	cmd := exec.Command(
+		"docker", "inspect",
+		"--format", "{{range .NetworkSettings.Networks}}{{.IPAddress}}{{end}}",
+		controlPlane,
+	)
+	lines, err := exec.OutputLines(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to inspect docker network for %s: %v", controlPlane, err)
+	}
+
+	pathOptions := clientcmd.NewDefaultPathOptions()
+	pathOptions.LoadingRules.ExplicitPath = kubeConfigPath
+	pathOptions.EnvVar = ""
+	config, err := pathOptions.GetStartingConfig()
+	if err != nil {
+		return err
+	}
+
+	config.Clusters[clusterContext].Server = fmt.Sprintf("https://%s:6443", lines[0])
+	return clientcmd.ModifyConfig(pathOptions, *config, true)
+}
+
+// waitExecutionSpaceCreated blocks until the cluster controller creates the execution
+// space namespace for clusterName, or executionSpaceReadyTimeout elapses.
+func waitExecutionSpaceCreated(kubeClient kubernetes.Interface, clusterName string) error {
+	executionSpace, err := names.GenerateExecutionSpaceName(clusterName)
+	if err != nil {
+		return fmt.Errorf("failed to generate execution space name for %s: %v", clusterName, err)
+	}
+
+	return wait.PollImmediate(executionSpaceReadyInterval, executionSpaceReadyTimeout, func() (bool, error) {
+		_, err := kubeClient.CoreV1().Namespaces().Get(context.TODO(), executionSpace, metav1.GetOptions{})
+		if err != nil {
+			return false, nil
+		}
+		return true, nil
+	})
+}
@@ -16,12 +16,11 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
-	"sigs.k8s.io/kind/pkg/cluster"
-	"sigs.k8s.io/kind/pkg/exec"
 
 	clusterapi "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	karmada "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/test/e2e/framework"
 	"github.com/karmada-io/karmada/test/helper"
 )
 
@@ -52,7 +51,7 @@ var (
 	clusterNames    []string
 	clusterClients  []*util.ClusterClient
 	testNamespace   = fmt.Sprintf("karmadatest-%s", rand.String(RandomStrLength))
-	clusterProvider *cluster.Provider
+	clusterProvider *framework.ClusterProvider
 )
 
 func TestE2E(t *testing.T) {
@@ -64,7 +63,7 @@ var _ = ginkgo.BeforeSuite(func() {
 	kubeconfig = os.Getenv("KUBECONFIG")
 	gomega.Expect(kubeconfig).ShouldNot(gomega.BeEmpty())
 
-	clusterProvider = cluster.NewProvider()
+	clusterProvider = framework.NewClusterProvider()
 	var err error
 	restConfig, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
 	gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
@@ -183,39 +182,45 @@ func getClusterClient(clusterName string) kubernetes.Interface {
 	return nil
 }
 
-func createCluster(clusterName, kubeConfigPath, controlPlane, clusterContext string) error {
-	err := clusterProvider.Create(clusterName, cluster.CreateWithKubeconfigPath(kubeConfigPath))
+// joinCluster spins up a new kind cluster and registers it with the karmada control
+// plane, folding the result into the suite-level clusters/clusterClients/clusterNames
+// slices so specs running after it see the cluster like any other member.
+func joinCluster(clusterName, kubeConfigPath, controlPlane, clusterContext string) error {
+	joined, clusterClient, err := clusterProvider.JoinKindCluster(kubeconfig, clusterName, kubeConfigPath, controlPlane, clusterContext, karmadaClient, kubeClient)
 	if err != nil {
 		return err
 	}
 
-	cmd := exec.Command(
-		"docker", "inspect",
-		"--format", "{{range .NetworkSettings.Networks}}{{.IPAddress}}{{end}}",
-		controlPlane,
-	)
-	lines, err := exec.OutputLines(cmd)
-	if err != nil {
-		return err
-	}
+	clusters = append(clusters, joined)
+	clusterNames = append(clusterNames, joined.Name)
+	clusterClients = append(clusterClients, clusterClient)
+	return nil
+}
 
-	pathOptions := clientcmd.NewDefaultPathOptions()
-	pathOptions.LoadingRules.ExplicitPath = kubeConfigPath
-	pathOptions.EnvVar = ""
-	config, err := pathOptions.GetStartingConfig()
-	if err != nil {
+// unjoinCluster removes clusterName from the karmada control plane, tears down its kind
+// cluster, and drops it from the suite-level clusters/clusterClients/clusterNames slices.
+func unjoinCluster(clusterName, kubeConfigPath string) error {
+	if err := clusterProvider.UnjoinKindCluster(kubeconfig, clusterName, kubeConfigPath); err != nil {
 		return err
 	}
 
-	serverIP := fmt.Sprintf("https://%s:6443", lines[0])
-	config.Clusters[clusterContext].Server = serverIP
-	err = clientcmd.ModifyConfig(pathOptions, *config, true)
-	if err != nil {
-		return err
+	for i, c := range clusters {
+		if c.Name == clusterName {
+			clusters = append(clusters[:i], clusters[i+1:]...)
+			break
+		}
+	}
+	for i, name := range clusterNames {
+		if name == clusterName {
+			clusterNames = append(clusterNames[:i], clusterNames[i+1:]...)
+			break
+		}
+	}
+	for i, cc := range clusterClients {
+		if cc.ClusterName == clusterName {
+			clusterClients = append(clusterClients[:i], clusterClients[i+1:]...)
+			break
+		}
 	}
 	return nil
 }
-
-func deleteCluster(clusterName, kubeConfigPath string) error {
-	return clusterProvider.Delete(clusterName, kubeConfigPath)
-}